@@ -0,0 +1,55 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/container-orchestrated-devices/container-device-interface/pkg/cdi"
+	specs "github.com/container-orchestrated-devices/container-device-interface/specs-go"
+	ocispecs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+// resolveCDIDevices merges the mounts, device nodes, environment variables
+// and OCI hooks contributed by devices into spec, in order. inlineSpecs is
+// parallel to devices: a non-empty entry at index i is parsed as a CDI Spec
+// JSON payload and applied directly instead of looking devices[i] up in the
+// on-disk CDI registry.
+//
+// User-supplied --device entries are expected to already be present in
+// spec, so CDI mounts and devices are appended after them: on a path
+// collision the runtime applies the later entry, so a CDI-resolved device
+// takes precedence over a user-supplied --device of the same path,
+// matching runc's merge order.
+func resolveCDIDevices(spec *ocispecs.Spec, devices, inlineSpecs []string) error {
+	registry := cdi.GetRegistry()
+
+	for i, device := range devices {
+		if i < len(inlineSpecs) && inlineSpecs[i] != "" {
+			if err := applyInlineCDISpec(spec, device, inlineSpecs[i]); err != nil {
+				return &CDIDeviceError{Device: device, Message: err.Error()}
+			}
+			continue
+		}
+
+		if _, err := registry.InjectDevices(spec, device); err != nil {
+			return &CDIDeviceError{Device: device, Message: err.Error()}
+		}
+	}
+
+	return nil
+}
+
+// applyInlineCDISpec resolves device against an inline CDI Spec payload
+// entirely in memory, bypassing the on-disk CDI registry.
+func applyInlineCDISpec(spec *ocispecs.Spec, device, rawSpec string) error {
+	_, _, name, err := cdi.ParseQualifiedName(device)
+	if err != nil {
+		return err
+	}
+
+	parsed, err := cdi.ParseSpec([]byte(rawSpec))
+	if err != nil {
+		return fmt.Errorf("parse inline cdi spec: %w", err)
+	}
+
+	return specs.ApplyOCIEditsForDevice(spec, parsed, name)
+}
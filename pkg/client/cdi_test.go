@@ -0,0 +1,125 @@
+package client
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/container-orchestrated-devices/container-device-interface/pkg/cdi"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+var _ = Describe("resolveCDIDevices", func() {
+	var (
+		spec    *specs.Spec
+		specDir string
+	)
+
+	BeforeEach(func() {
+		spec = &specs.Spec{
+			Process: &specs.Process{Env: []string{"PATH=/usr/bin"}},
+			Linux:   &specs.Linux{Devices: []specs.LinuxDevice{{Path: "/dev/user-gpu0", Type: "c"}}},
+		}
+
+		var err error
+		specDir, err = os.MkdirTemp("", "cdi-test-")
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(func() { os.RemoveAll(specDir) })
+
+		// WithAutoRefresh(false) makes Refresh() actually re-scan specDir
+		// synchronously; in auto-refresh mode (the default) Refresh only
+		// re-reads on a spec dir appearing, relying on an async fsnotify
+		// watch otherwise, which races with writeCDISpec below.
+		cdi.GetRegistry(cdi.WithSpecDirs(specDir), cdi.WithAutoRefresh(false)).Refresh()
+	})
+
+	It("resolves a fully-qualified device name against the CDI registry", func() {
+		writeCDISpec(specDir, "vendor.json", `{
+			"cdiVersion": "0.5.0",
+			"kind": "vendor.com/class",
+			"devices": [{
+				"name": "dev0",
+				"containerEdits": {
+					"env": ["CDI_INJECTED=1"],
+					"deviceNodes": [{"path": "/dev/vendor-dev0", "type": "c", "major": 1, "minor": 1}]
+				}
+			}]
+		}`)
+
+		err := resolveCDIDevices(spec, []string{"vendor.com/class=dev0"}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(spec.Process.Env).To(ContainElement("CDI_INJECTED=1"))
+	})
+
+	It("merges CDI-resolved devices after user-supplied --device entries", func() {
+		writeCDISpec(specDir, "vendor.json", `{
+			"cdiVersion": "0.5.0",
+			"kind": "vendor.com/class",
+			"devices": [{
+				"name": "dev0",
+				"containerEdits": {
+					"deviceNodes": [{"path": "/dev/vendor-dev0", "type": "c", "major": 1, "minor": 1}]
+				}
+			}]
+		}`)
+
+		Expect(resolveCDIDevices(spec, []string{"vendor.com/class=dev0"}, nil)).To(Succeed())
+
+		Expect(spec.Linux.Devices[0].Path).To(Equal("/dev/user-gpu0"))
+		Expect(spec.Linux.Devices[len(spec.Linux.Devices)-1].Path).To(Equal("/dev/vendor-dev0"))
+	})
+
+	It("uses an inline spec instead of the on-disk registry when provided", func() {
+		err := resolveCDIDevices(spec, []string{"vendor.com/class=dev0"}, []string{`{
+			"cdiVersion": "0.5.0",
+			"kind": "vendor.com/class",
+			"devices": [{
+				"name": "dev0",
+				"containerEdits": {"env": ["CDI_INLINE=1"]}
+			}]
+		}`})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(spec.Process.Env).To(ContainElement("CDI_INLINE=1"))
+	})
+
+	It("orders hooks from multiple devices by argument order", func() {
+		writeCDISpec(specDir, "a.json", `{
+			"cdiVersion": "0.5.0",
+			"kind": "vendor.com/hooka",
+			"devices": [{
+				"name": "dev0",
+				"containerEdits": {"hooks": [{"hookName": "createRuntime", "path": "/usr/bin/a-hook"}]}
+			}]
+		}`)
+		writeCDISpec(specDir, "b.json", `{
+			"cdiVersion": "0.5.0",
+			"kind": "vendor.com/hookb",
+			"devices": [{
+				"name": "dev0",
+				"containerEdits": {"hooks": [{"hookName": "createRuntime", "path": "/usr/bin/b-hook"}]}
+			}]
+		}`)
+
+		err := resolveCDIDevices(spec, []string{"vendor.com/hooka=dev0", "vendor.com/hookb=dev0"}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(spec.Hooks.CreateRuntime[0].Path).To(Equal("/usr/bin/a-hook"))
+		Expect(spec.Hooks.CreateRuntime[1].Path).To(Equal("/usr/bin/b-hook"))
+	})
+
+	It("returns a structured error identifying the device that failed to resolve", func() {
+		err := resolveCDIDevices(spec, []string{"vendor.com/missing=dev0"}, nil)
+		Expect(err).To(HaveOccurred())
+
+		var cdiErr *CDIDeviceError
+		Expect(errors.As(err, &cdiErr)).To(BeTrue())
+		Expect(cdiErr.Device).To(Equal("vendor.com/missing=dev0"))
+	})
+})
+
+func writeCDISpec(dir, name, contents string) {
+	Expect(os.WriteFile(filepath.Join(dir, name), []byte(contents), 0o644)).To(Succeed())
+	Expect(cdi.GetRegistry().Refresh()).To(Succeed())
+}
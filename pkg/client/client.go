@@ -0,0 +1,537 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/rpc"
+	"github.com/containers/conmon-rs/internal/proto"
+)
+
+// ConmonClient is the main client to speak with the conmon-rs server.
+type ConmonClient struct {
+	serverPath string
+	conn       *rpc.Conn
+	conmon     proto.Conmon
+}
+
+// ConmonServerConfig is the configuration for calling New().
+type ConmonServerConfig struct {
+	// ConmonServerPath is the path to the conmon-rs unix socket.
+	ConmonServerPath string
+}
+
+// New creates a new ConmonClient connected to the conmon-rs server
+// described by cfg.
+func New(cfg *ConmonServerConfig) (*ConmonClient, error) {
+	conn, err := net.Dial("unix", cfg.ConmonServerPath)
+	if err != nil {
+		return nil, fmt.Errorf("dial conmon-rs server: %w", err)
+	}
+
+	transport := rpc.NewStreamTransport(conn)
+	rpcConn := rpc.NewConn(transport, nil)
+
+	return &ConmonClient{
+		serverPath: cfg.ConmonServerPath,
+		conn:       rpcConn,
+		conmon:     proto.Conmon{Client: rpcConn.Bootstrap(context.Background())},
+	}, nil
+}
+
+// Close tears down the underlying connection to the conmon-rs server.
+func (c *ConmonClient) Close() error {
+	return c.conn.Close()
+}
+
+// CreateContainerConfig is the configuration for calling CreateContainer.
+type CreateContainerConfig struct {
+	// ID is the container id.
+	ID string
+
+	// BundlePath is the OCI bundle directory containing config.json and
+	// the root filesystem.
+	BundlePath string
+
+	// CDIDevices names fully-qualified CDI devices (e.g. "nvidia.com/gpu=all")
+	// to resolve and inject into the container's runtime spec.
+	CDIDevices []string
+
+	// CDISpecs carries inline CDI Spec JSON payloads, keyed by the same
+	// index as CDIDevices, for callers that have already resolved a
+	// device and want to skip the on-disk CDI registry lookup for it. An
+	// empty string at a given index falls back to the registry lookup.
+	CDISpecs []string
+
+	// LogDrivers lists the stdio log sinks conmon-rs should write to.
+	LogDrivers []LogDriver
+}
+
+// LogDriverType selects the on-disk log format a LogDriver writes.
+type LogDriverType int
+
+const (
+	// LogDriverTypeContainerRuntimeInterface writes the k8s-format log
+	// used by `kubectl logs`.
+	LogDriverTypeContainerRuntimeInterface LogDriverType = iota
+
+	// LogDriverTypeJSON writes Docker/OCI-style JSON-lines log entries.
+	LogDriverTypeJSON
+)
+
+// LogDriver configures one stdio log sink and its rotation policy. Log
+// files are rotated atomically via rename while conmon-rs holds the write
+// lock, so no line is ever split across segments, and rotated segments are
+// kept as a numeric ".N" suffix compatible with `kubectl logs --previous`.
+type LogDriver struct {
+	// Type selects the on-disk log format.
+	Type LogDriverType
+
+	// Path is where the active log segment is written.
+	Path string
+
+	// MaxSize rotates the active segment once it reaches this many
+	// bytes. Zero disables size-based rotation.
+	MaxSize uint64
+
+	// MaxFiles bounds how many rotated segments are kept on disk before
+	// the oldest is removed. Zero keeps all segments.
+	MaxFiles uint32
+
+	// MaxAge rotates the active segment once it is older than this,
+	// regardless of size. Zero disables age-based rotation. It is sent to
+	// conmon-rs in whole seconds, truncated, so a value under one second
+	// also disables age-based rotation.
+	MaxAge time.Duration
+
+	// Compress gzips a segment once it has been rotated out of the
+	// active position.
+	Compress bool
+}
+
+// CreateContainerResult is returned by CreateContainer.
+type CreateContainerResult struct {
+	// ContainerPID is the pid of the newly created container process.
+	ContainerPID uint32
+}
+
+// CreateContainer asks the conmon-rs server to create a new container from
+// cfg.BundlePath. Any CDI devices named in cfg are resolved and merged into
+// the runtime spec before the OCI runtime is invoked.
+func (c *ConmonClient) CreateContainer(ctx context.Context, cfg *CreateContainerConfig) (*CreateContainerResult, error) {
+	future, free := c.conmon.CreateContainer(ctx, func(p proto.Conmon_createContainer_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return err
+		}
+		if err := req.SetId(cfg.ID); err != nil {
+			return err
+		}
+		if err := req.SetBundlePath(cfg.BundlePath); err != nil {
+			return err
+		}
+		if err := setTextList(req.NewCdiDevices, cfg.CDIDevices); err != nil {
+			return err
+		}
+		if err := setTextList(req.NewCdiSpecs, cfg.CDISpecs); err != nil {
+			return err
+		}
+		if err := setLogDrivers(req, cfg.LogDrivers); err != nil {
+			return err
+		}
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	if err != nil {
+		return nil, fmt.Errorf("call create container: %w", err)
+	}
+	resp, err := result.Response()
+	if err != nil {
+		return nil, fmt.Errorf("read create container response: %w", err)
+	}
+
+	if resp.Which() == proto.CreateContainerResponse_Which_cdiError {
+		cdiErr, err := resp.CdiError()
+		if err != nil {
+			return nil, fmt.Errorf("read cdi error: %w", err)
+		}
+		device, err := cdiErr.Device()
+		if err != nil {
+			return nil, fmt.Errorf("read cdi error device: %w", err)
+		}
+		message, err := cdiErr.Message()
+		if err != nil {
+			return nil, fmt.Errorf("read cdi error message: %w", err)
+		}
+		return nil, &CDIDeviceError{Device: device, Message: message}
+	}
+
+	return &CreateContainerResult{ContainerPID: resp.ContainerPid()}, nil
+}
+
+// CDIDeviceError is returned when a CDI device named in
+// CreateContainerConfig.CDIDevices could not be resolved or merged into the
+// runtime spec.
+type CDIDeviceError struct {
+	Device  string
+	Message string
+}
+
+func (e *CDIDeviceError) Error() string {
+	return fmt.Sprintf("resolve cdi device %q: %s", e.Device, e.Message)
+}
+
+func setTextList(set func(int32) (capnp.TextList, error), values []string) error {
+	list, err := set(int32(len(values)))
+	if err != nil {
+		return err
+	}
+	for i, v := range values {
+		if err := list.Set(i, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func setLogDrivers(req proto.CreateContainerRequest, drivers []LogDriver) error {
+	list, err := req.NewLogDrivers(int32(len(drivers)))
+	if err != nil {
+		return err
+	}
+	for i, d := range drivers {
+		elem := list.At(i)
+		switch d.Type {
+		case LogDriverTypeJSON:
+			elem.SetJson()
+			if err := elem.Json().SetLogPath(d.Path); err != nil {
+				return err
+			}
+		default:
+			elem.SetContainerRuntimeInterface()
+			if err := elem.ContainerRuntimeInterface().SetLogPath(d.Path); err != nil {
+				return err
+			}
+		}
+		elem.SetMaxSize(d.MaxSize)
+		elem.SetMaxFiles(d.MaxFiles)
+		elem.SetMaxAgeSeconds(uint64(d.MaxAge.Seconds()))
+		elem.SetCompress(d.Compress)
+	}
+	return nil
+}
+
+// ReopenLogs triggers rotation of the container's log files out of band,
+// for callers that manage rotation externally (e.g. forwarding a SIGHUP to
+// conmon-rs the way logrotate's `copytruncate` scripts do) instead of
+// relying on a LogDriver's MaxSize/MaxAge.
+func (c *ConmonClient) ReopenLogs(ctx context.Context, id string) error {
+	future, free := c.conmon.ReopenLogs(ctx, func(p proto.Conmon_reopenLogs_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return err
+		}
+		return req.SetId(id)
+	})
+	defer free()
+
+	if _, err := future.Struct(); err != nil {
+		return fmt.Errorf("call reopen logs: %w", err)
+	}
+	return nil
+}
+
+// CheckpointConfig holds the CRIU options accepted by CheckpointContainer.
+// It mirrors the set of flags `runc`/`crun checkpoint` accept, excluding
+// the container id and bundle path, which conmon-rs already tracks.
+type CheckpointConfig struct {
+	// ID is the container id to checkpoint.
+	ID string
+
+	// ImageDir is where the CRIU checkpoint image is written.
+	ImageDir string
+
+	// WorkDir is CRIU's scratch/log directory, defaults to ImageDir when empty.
+	WorkDir string
+
+	// LeaveRunning keeps the container process running after the dump completes.
+	LeaveRunning bool
+
+	// TCPEstablished allows checkpointing containers with open TCP connections.
+	TCPEstablished bool
+
+	// FileLocks checkpoints and restores any held file locks.
+	FileLocks bool
+
+	// PreDump performs an iterative pre-dump, leaving the container running
+	// and writing incremental pages that a later full dump can reuse.
+	PreDump bool
+
+	// ParentImageDir points at a prior (pre-)dump to diff against, for
+	// iterative dumps started with PreDump.
+	ParentImageDir string
+
+	// StatsPath is where CRIU writes its dump statistics, if set.
+	StatsPath string
+}
+
+// CheckpointResult is returned by CheckpointContainer.
+type CheckpointResult struct {
+	// ExitCode is the exit code of the underlying `runtime checkpoint` invocation.
+	ExitCode int32
+
+	// LogTail holds the last lines of criu's own log, regardless of ExitCode,
+	// so failures can be diagnosed without shelling out to read the image
+	// directory.
+	LogTail []string
+}
+
+// CheckpointError wraps a non-zero CheckpointResult so callers can surface
+// the CRIU exit code and log tail without re-reading the image directory.
+type CheckpointError struct {
+	ExitCode int32
+	LogTail  []string
+}
+
+func (e *CheckpointError) Error() string {
+	return fmt.Sprintf("criu checkpoint failed with exit code %d: %s", e.ExitCode, lastLine(e.LogTail))
+}
+
+// RestoreConfig holds the CRIU options accepted by RestoreContainer.
+type RestoreConfig struct {
+	// ID is the container id to restore into.
+	ID string
+
+	// ImageDir is the checkpoint image directory produced by a prior
+	// CheckpointContainer call.
+	ImageDir string
+
+	// WorkDir is CRIU's scratch/log directory, defaults to ImageDir when empty.
+	WorkDir string
+
+	// TCPEstablished restores previously open TCP connections.
+	TCPEstablished bool
+
+	// FileLocks restores previously held file locks.
+	FileLocks bool
+}
+
+// RestoreResult is returned by RestoreContainer.
+type RestoreResult struct {
+	// ContainerPID is the pid of the restored container process.
+	ContainerPID uint32
+
+	// ExitCode is the exit code of the underlying `runtime restore` invocation.
+	ExitCode int32
+
+	// LogTail holds the last lines of criu's own log.
+	LogTail []string
+}
+
+// RestoreError wraps a non-zero RestoreResult.
+type RestoreError struct {
+	ExitCode int32
+	LogTail  []string
+}
+
+func (e *RestoreError) Error() string {
+	return fmt.Sprintf("criu restore failed with exit code %d: %s", e.ExitCode, lastLine(e.LogTail))
+}
+
+// CheckpointPhase describes the current state of an in-flight checkpoint.
+type CheckpointPhase int
+
+const (
+	CheckpointPhaseRunning CheckpointPhase = iota
+	CheckpointPhaseSucceeded
+	CheckpointPhaseFailed
+)
+
+// CheckpointStatusResult is returned by CheckpointStatus.
+type CheckpointStatusResult struct {
+	Phase    CheckpointPhase
+	ExitCode int32
+	LogTail  []string
+}
+
+// CheckpointContainer asks the conmon-rs server to drive a CRIU dump of the
+// container identified by cfg.ID. The call always blocks until the dump
+// completes and returns its final exit code and log tail; this holds for an
+// iterative pre-dump (cfg.PreDump) as well. Callers that want
+// to observe progress of a long-running dump without blocking should poll
+// CheckpointStatus instead.
+func (c *ConmonClient) CheckpointContainer(ctx context.Context, cfg *CheckpointConfig) (*CheckpointResult, error) {
+	future, free := c.conmon.CheckpointContainer(ctx, func(p proto.Conmon_checkpointContainer_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return err
+		}
+		if err := req.SetId(cfg.ID); err != nil {
+			return err
+		}
+		if err := req.SetImagePath(cfg.ImageDir); err != nil {
+			return err
+		}
+		if err := req.SetWorkPath(cfg.WorkDir); err != nil {
+			return err
+		}
+		if err := req.SetParentImagePath(cfg.ParentImageDir); err != nil {
+			return err
+		}
+		if err := req.SetStatsPath(cfg.StatsPath); err != nil {
+			return err
+		}
+		req.SetLeaveRunning(cfg.LeaveRunning)
+		req.SetTcpEstablished(cfg.TCPEstablished)
+		req.SetFileLocks(cfg.FileLocks)
+		req.SetPreDump(cfg.PreDump)
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	if err != nil {
+		return nil, fmt.Errorf("call checkpoint container: %w", err)
+	}
+	resp, err := result.Response()
+	if err != nil {
+		return nil, fmt.Errorf("read checkpoint response: %w", err)
+	}
+
+	rawLogTail, err := resp.LogTail()
+	if err != nil {
+		return nil, fmt.Errorf("read checkpoint log tail: %w", err)
+	}
+	logTail, err := textListToSlice(rawLogTail)
+	if err != nil {
+		return nil, fmt.Errorf("read checkpoint log tail: %w", err)
+	}
+
+	if exitCode := resp.ExitCode(); exitCode != 0 {
+		return nil, &CheckpointError{ExitCode: exitCode, LogTail: logTail}
+	}
+
+	return &CheckpointResult{ExitCode: resp.ExitCode(), LogTail: logTail}, nil
+}
+
+// RestoreContainer asks the conmon-rs server to drive a CRIU restore of a
+// checkpoint previously produced by CheckpointContainer.
+func (c *ConmonClient) RestoreContainer(ctx context.Context, cfg *RestoreConfig) (*RestoreResult, error) {
+	future, free := c.conmon.RestoreContainer(ctx, func(p proto.Conmon_restoreContainer_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return err
+		}
+		if err := req.SetId(cfg.ID); err != nil {
+			return err
+		}
+		if err := req.SetImagePath(cfg.ImageDir); err != nil {
+			return err
+		}
+		if err := req.SetWorkPath(cfg.WorkDir); err != nil {
+			return err
+		}
+		req.SetTcpEstablished(cfg.TCPEstablished)
+		req.SetFileLocks(cfg.FileLocks)
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	if err != nil {
+		return nil, fmt.Errorf("call restore container: %w", err)
+	}
+	resp, err := result.Response()
+	if err != nil {
+		return nil, fmt.Errorf("read restore response: %w", err)
+	}
+
+	rawLogTail, err := resp.LogTail()
+	if err != nil {
+		return nil, fmt.Errorf("read restore log tail: %w", err)
+	}
+	logTail, err := textListToSlice(rawLogTail)
+	if err != nil {
+		return nil, fmt.Errorf("read restore log tail: %w", err)
+	}
+
+	if exitCode := resp.ExitCode(); exitCode != 0 {
+		return nil, &RestoreError{ExitCode: exitCode, LogTail: logTail}
+	}
+
+	return &RestoreResult{
+		ContainerPID: resp.ContainerPid(),
+		ExitCode:     resp.ExitCode(),
+		LogTail:      logTail,
+	}, nil
+}
+
+// CheckpointStatus polls the state of a checkpoint started with
+// CheckpointConfig.LeaveRunning or CheckpointConfig.PreDump, for callers
+// that don't want to block on CheckpointContainer.
+func (c *ConmonClient) CheckpointStatus(ctx context.Context, id string) (*CheckpointStatusResult, error) {
+	future, free := c.conmon.CheckpointStatus(ctx, func(p proto.Conmon_checkpointStatus_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return err
+		}
+		return req.SetId(id)
+	})
+	defer free()
+
+	result, err := future.Struct()
+	if err != nil {
+		return nil, fmt.Errorf("call checkpoint status: %w", err)
+	}
+	resp, err := result.Response()
+	if err != nil {
+		return nil, fmt.Errorf("read checkpoint status response: %w", err)
+	}
+
+	rawLogTail, err := resp.LogTail()
+	if err != nil {
+		return nil, fmt.Errorf("read checkpoint status log tail: %w", err)
+	}
+	logTail, err := textListToSlice(rawLogTail)
+	if err != nil {
+		return nil, fmt.Errorf("read checkpoint status log tail: %w", err)
+	}
+
+	phase := CheckpointPhaseRunning
+	switch resp.Phase() {
+	case proto.CheckpointPhase_succeeded:
+		phase = CheckpointPhaseSucceeded
+	case proto.CheckpointPhase_failed:
+		phase = CheckpointPhaseFailed
+	}
+
+	return &CheckpointStatusResult{
+		Phase:    phase,
+		ExitCode: resp.ExitCode(),
+		LogTail:  logTail,
+	}, nil
+}
+
+func textListToSlice(list capnp.TextList) ([]string, error) {
+	out := make([]string, list.Len())
+	for i := range out {
+		s, err := list.At(i)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = s
+	}
+	return out, nil
+}
+
+func lastLine(lines []string) string {
+	if len(lines) == 0 {
+		return ""
+	}
+	return lines[len(lines)-1]
+}
@@ -0,0 +1,410 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/containers/conmon-rs/internal/proto"
+)
+
+// StreamID identifies which logical stream a frame on a multiplexed
+// attach/exec connection belongs to.
+type StreamID uint8
+
+const (
+	StreamIDStdin      StreamID = 0
+	StreamIDStdout     StreamID = 1
+	StreamIDStderr     StreamID = 2
+	StreamIDResize     StreamID = 3
+	StreamIDExitStatus StreamID = 4
+	StreamIDError      StreamID = 5
+	StreamIDLogRotated StreamID = 6
+)
+
+// TerminalSize is a terminal resize event, compatible with
+// k8s.io/client-go/tools/remotecommand.TerminalSize so a ContainerIO's
+// Resize channel can back a remotecommand.TerminalSizeQueue directly.
+type TerminalSize struct {
+	Width  uint16
+	Height uint16
+}
+
+// StreamAttachConfig is the configuration for calling StreamAttach.
+type StreamAttachConfig struct {
+	// ID is the container id to attach to.
+	ID string
+
+	// TTY must match the container's own terminal setting; it enables
+	// Resize events on the returned ContainerIO for already-running TTY
+	// containers (e.g. `podman attach`, kubelet attach).
+	TTY bool
+}
+
+// StreamExecConfig is the configuration for calling StreamExec.
+type StreamExecConfig struct {
+	// ID is the container id to exec into.
+	ID string
+
+	// Command is the argv of the process to run.
+	Command []string
+
+	// TTY allocates a pseudo-terminal for the exec process, enabling
+	// Resize events on the returned ContainerIO.
+	TTY bool
+}
+
+// LogRotatedEvent is delivered on ContainerIO.LogRotated when conmon-rs
+// rotates a log file the caller is tailing, so it knows to reopen it.
+type LogRotatedEvent struct {
+	ID          string
+	Path        string
+	RotatedPath string
+}
+
+// ContainerIO adapts a multiplexed attach/exec connection to a pair of
+// io.Reader/io.Writer for stdio and a resize channel, so kubelet-style
+// callers can plug it directly into remotecommand.StreamWithContext.
+type ContainerIO struct {
+	// Stdin is written to the container's stdin.
+	Stdin io.WriteCloser
+
+	// Stdout and Stderr are read from as the container produces output.
+	Stdout io.Reader
+	Stderr io.Reader
+
+	// Resize delivers TTY resize events to forward to the container.
+	// Only populated for TTY sessions (see StreamAttachConfig.TTY and
+	// StreamExecConfig.TTY).
+	Resize chan<- TerminalSize
+
+	// LogRotated delivers a LogRotatedEvent each time conmon-rs rotates a
+	// log file this session is attached to. Events are best-effort: the
+	// channel holds one event, and a rotation that arrives while an earlier
+	// event is still undrained is dropped, so a consumer that falls behind
+	// sees the oldest undrained rotation rather than the most recent one.
+	// Either is enough to know to reopen the file it's tailing.
+	LogRotated <-chan LogRotatedEvent
+
+	mux *frameMux
+}
+
+// Wait blocks until the attached process exits and returns its exit
+// status, or the error the server sent in its place.
+func (c *ContainerIO) Wait(ctx context.Context) (int32, error) {
+	select {
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	case code := <-c.mux.exitStatus:
+		return code, nil
+	case err := <-c.mux.streamErr:
+		return 0, err
+	}
+}
+
+// Frame is the Go-side representation of a proto.StreamFrame.
+type Frame struct {
+	StreamID   StreamID
+	Payload    []byte
+	LogRotated LogRotatedEvent
+}
+
+// frameMux demultiplexes inbound StreamFrames onto per-stream pipes.
+type frameMux struct {
+	stdoutW, stderrW *io.PipeWriter
+
+	exitStatus chan int32
+	streamErr  chan error
+	logRotated chan LogRotatedEvent
+
+	mu     sync.Mutex
+	closed bool
+}
+
+func newFrameMux() (*frameMux, *io.PipeReader, *io.PipeReader) {
+	stdoutR, stdoutW := io.Pipe()
+	stderrR, stderrW := io.Pipe()
+
+	return &frameMux{
+		stdoutW:    stdoutW,
+		stderrW:    stderrW,
+		exitStatus: make(chan int32, 1),
+		streamErr:  make(chan error, 1),
+		logRotated: make(chan LogRotatedEvent, 1),
+	}, stdoutR, stderrR
+}
+
+// handleFrame routes one inbound frame to its stream. For stdout/stderr it
+// blocks until the consumer has read the payload off the pipe. capnp-go
+// serializes calls on a capability behind the return or Call.Ack of the
+// previous one, so frameSinkServer acks the delivering FrameSink.write call
+// before invoking handleFrame: a slow stdout reader then only delays the
+// goroutine processing that write, not the delivery of the next frame on
+// stderr, exit-status or error.
+func (m *frameMux) handleFrame(f Frame) error {
+	switch f.StreamID {
+	case StreamIDStdout:
+		_, err := m.stdoutW.Write(f.Payload)
+		return err
+	case StreamIDStderr:
+		_, err := m.stderrW.Write(f.Payload)
+		return err
+	case StreamIDExitStatus:
+		code, err := decodeExitStatus(f.Payload)
+		if err != nil {
+			return err
+		}
+		m.exitStatus <- code
+		return m.closeOutputs(nil)
+	case StreamIDError:
+		err := fmt.Errorf("stream error: %s", f.Payload)
+		m.streamErr <- err
+		return m.closeOutputs(err)
+	case StreamIDLogRotated:
+		// Best-effort: if the caller hasn't drained the previous event
+		// from the one-slot channel yet, this one is dropped and the
+		// caller still sees the earlier event.
+		select {
+		case m.logRotated <- f.LogRotated:
+		default:
+		}
+		return nil
+	default:
+		return fmt.Errorf("unexpected stream id on inbound frame: %d", f.StreamID)
+	}
+}
+
+func (m *frameMux) closeOutputs(err error) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.closed {
+		return nil
+	}
+	m.closed = true
+	m.stdoutW.CloseWithError(err)
+	m.stderrW.CloseWithError(err)
+	return nil
+}
+
+func decodeExitStatus(payload []byte) (int32, error) {
+	if len(payload) != 4 {
+		return 0, fmt.Errorf("malformed exit status frame: want 4 bytes, got %d", len(payload))
+	}
+	return int32(payload[0]) | int32(payload[1])<<8 | int32(payload[2])<<16 | int32(payload[3])<<24, nil
+}
+
+// frameSinkServer implements proto.FrameSink_Server, the capability the Go
+// client hands to the server so it can push frames as they become
+// available.
+type frameSinkServer struct {
+	mux *frameMux
+}
+
+func (s *frameSinkServer) Write(ctx context.Context, call proto.FrameSink_write) error {
+	frame, err := call.Args().Frame()
+	if err != nil {
+		return err
+	}
+
+	// Ack delivery before handleFrame, which may block on a slow stdout/
+	// stderr reader: without this, capnp-go would hold every subsequent
+	// FrameSink.write call (on any stream) behind this one's return.
+	call.Ack()
+
+	f := Frame{StreamID: StreamID(frame.StreamId())}
+	if frame.Which() == proto.StreamFrame_Which_logRotated {
+		ev, err := frame.LogRotated()
+		if err != nil {
+			return err
+		}
+		id, err := ev.Id()
+		if err != nil {
+			return err
+		}
+		path, err := ev.Path()
+		if err != nil {
+			return err
+		}
+		rotatedPath, err := ev.RotatedPath()
+		if err != nil {
+			return err
+		}
+		f.LogRotated = LogRotatedEvent{ID: id, Path: path, RotatedPath: rotatedPath}
+	} else {
+		payload, err := frame.Payload()
+		if err != nil {
+			return err
+		}
+		f.Payload = payload
+	}
+
+	return s.mux.handleFrame(f)
+}
+
+// stdinWriter adapts io.Writer/io.Closer onto the StreamWriter capability
+// returned by streamAttach/streamExec. Each call is made against the
+// writer's capnp promise directly (promise pipelining), so a caller can
+// start writing stdin before the attach/exec call itself has resolved.
+type stdinWriter struct {
+	ctx    context.Context
+	writer proto.StreamWriter
+}
+
+func (w *stdinWriter) Write(p []byte) (int, error) {
+	future, free := w.writer.WriteStdin(w.ctx, func(p2 proto.StreamWriter_writeStdin_Params) error {
+		return p2.SetPayload(p)
+	})
+	defer free()
+	if _, err := future.Struct(); err != nil {
+		return 0, fmt.Errorf("write stdin: %w", err)
+	}
+	return len(p), nil
+}
+
+func (w *stdinWriter) Close() error {
+	future, free := w.writer.CloseStdin(w.ctx, func(proto.StreamWriter_closeStdin_Params) error {
+		return nil
+	})
+	defer free()
+	_, err := future.Struct()
+	return err
+}
+
+// StreamAttach multiplexes stdin, stdout, stderr, resize and exit events
+// for the already-running container cfg.ID onto a single connection.
+func (c *ConmonClient) StreamAttach(ctx context.Context, cfg *StreamAttachConfig) (*ContainerIO, error) {
+	mux, stdoutR, stderrR := newFrameMux()
+	sinkClient := proto.FrameSink_ServerToClient(&frameSinkServer{mux: mux}, nil)
+
+	future, free := c.conmon.StreamAttach(ctx, func(p proto.Conmon_streamAttach_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return err
+		}
+		if err := req.SetId(cfg.ID); err != nil {
+			return err
+		}
+		req.SetTty(cfg.TTY)
+		return p.SetSink(sinkClient)
+	})
+
+	writer := future.Writer()
+	cio := &ContainerIO{
+		Stdout:     stdoutR,
+		Stderr:     stderrR,
+		Stdin:      &stdinWriter{ctx: ctx, writer: writer},
+		LogRotated: mux.logRotated,
+		mux:        mux,
+	}
+
+	if cfg.TTY {
+		resize := make(chan TerminalSize, 1)
+		cio.Resize = resize
+		go forwardResizes(ctx, capnpResizeSender{writer: writer}, resize)
+	}
+
+	go func() {
+		defer free()
+		result, err := future.Struct()
+		if err != nil {
+			mux.streamErr <- fmt.Errorf("stream attach: %w", err)
+			return
+		}
+		mux.exitStatus <- result.ExitCode()
+	}()
+
+	return cio, nil
+}
+
+// StreamExec is StreamAttach's counterpart for a one-off exec process.
+func (c *ConmonClient) StreamExec(ctx context.Context, cfg *StreamExecConfig) (*ContainerIO, error) {
+	mux, stdoutR, stderrR := newFrameMux()
+	sinkClient := proto.FrameSink_ServerToClient(&frameSinkServer{mux: mux}, nil)
+
+	future, free := c.conmon.StreamExec(ctx, func(p proto.Conmon_streamExec_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return err
+		}
+		if err := req.SetId(cfg.ID); err != nil {
+			return err
+		}
+		if err := setTextList(req.NewCommand, cfg.Command); err != nil {
+			return err
+		}
+		req.SetTty(cfg.TTY)
+		return p.SetSink(sinkClient)
+	})
+
+	writer := future.Writer()
+	cio := &ContainerIO{
+		Stdout:     stdoutR,
+		Stderr:     stderrR,
+		Stdin:      &stdinWriter{ctx: ctx, writer: writer},
+		LogRotated: mux.logRotated,
+		mux:        mux,
+	}
+
+	if cfg.TTY {
+		resize := make(chan TerminalSize, 1)
+		cio.Resize = resize
+		go forwardResizes(ctx, capnpResizeSender{writer: writer}, resize)
+	}
+
+	go func() {
+		defer free()
+		result, err := future.Struct()
+		if err != nil {
+			mux.streamErr <- fmt.Errorf("stream exec: %w", err)
+			return
+		}
+		mux.exitStatus <- result.ExitCode()
+	}()
+
+	return cio, nil
+}
+
+// resizeSender is the slice of StreamWriter that forwardResizes needs,
+// kept narrow so tests can fake it without a real capnp connection.
+type resizeSender interface {
+	Resize(ctx context.Context, size TerminalSize) error
+}
+
+type capnpResizeSender struct {
+	writer proto.StreamWriter
+}
+
+func (s capnpResizeSender) Resize(ctx context.Context, size TerminalSize) error {
+	future, free := s.writer.Resize(ctx, func(p proto.StreamWriter_resize_Params) error {
+		sz, err := p.NewSize()
+		if err != nil {
+			return err
+		}
+		sz.SetWidth(size.Width)
+		sz.SetHeight(size.Height)
+		return nil
+	})
+	defer free()
+	_, err := future.Struct()
+	return err
+}
+
+// forwardResizes relays TerminalSize events to sender for as long as
+// resize stays open, including resizes that arrive well after the session
+// started.
+func forwardResizes(ctx context.Context, sender resizeSender, resize <-chan TerminalSize) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case size, ok := <-resize:
+			if !ok {
+				return
+			}
+			// Best-effort: a dropped resize just leaves the TTY at its
+			// previous size until the next one arrives.
+			_ = sender.Resize(ctx, size)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	capnp "capnproto.org/go/capnp/v3"
+	"github.com/containers/conmon-rs/internal/proto"
+)
+
+var _ = Describe("frameMux", func() {
+	var (
+		mux              *frameMux
+		stdoutR, stderrR *io.PipeReader
+	)
+
+	BeforeEach(func() {
+		mux, stdoutR, stderrR = newFrameMux()
+	})
+
+	It("delivers a frame split across multiple partial writes", func() {
+		done := make(chan struct{})
+		var got bytes.Buffer
+		go func() {
+			defer close(done)
+			io.CopyN(&got, stdoutR, 11)
+		}()
+
+		Expect(mux.handleFrame(Frame{StreamID: StreamIDStdout, Payload: []byte("hello ")})).To(Succeed())
+		Expect(mux.handleFrame(Frame{StreamID: StreamIDStdout, Payload: []byte("world")})).To(Succeed())
+
+		Eventually(done).Should(BeClosed())
+		Expect(got.String()).To(Equal("hello world"))
+	})
+
+	It("keeps stderr frames independent of a blocked stdout consumer", func() {
+		stdoutWrote := make(chan error, 1)
+		go func() {
+			stdoutWrote <- mux.handleFrame(Frame{StreamID: StreamIDStdout, Payload: []byte("blocked")})
+		}()
+
+		// No one is reading stdoutR yet, so the write above should not
+		// have completed, but stderr delivery must still proceed.
+		Consistently(stdoutWrote, 50*time.Millisecond).ShouldNot(Receive())
+
+		stderrDone := make(chan error, 1)
+		go func() {
+			_, err := io.ReadAll(io.LimitReader(stderrR, 2))
+			stderrDone <- err
+		}()
+		Expect(mux.handleFrame(Frame{StreamID: StreamIDStderr, Payload: []byte("ok")})).To(Succeed())
+		Eventually(stderrDone).Should(Receive(BeNil()))
+
+		// Draining stdout now unblocks the earlier write (backpressure
+		// applied, but delivery wasn't lost).
+		go io.ReadAll(stdoutR)
+		Eventually(stdoutWrote).Should(Receive(BeNil()))
+	})
+
+	It("applies backpressure: a stdout write blocks until the reader drains it", func() {
+		wrote := make(chan error, 1)
+		go func() {
+			wrote <- mux.handleFrame(Frame{StreamID: StreamIDStdout, Payload: []byte("12345678")})
+		}()
+
+		Consistently(wrote, 50*time.Millisecond).ShouldNot(Receive())
+
+		buf := make([]byte, 8)
+		n, err := io.ReadFull(stdoutR, buf)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(n).To(Equal(8))
+
+		Eventually(wrote).Should(Receive(BeNil()))
+	})
+
+	It("closes stdout/stderr and surfaces the exit code once an exit frame arrives", func() {
+		go io.ReadAll(stdoutR)
+		go io.ReadAll(stderrR)
+
+		Expect(mux.handleFrame(Frame{StreamID: StreamIDExitStatus, Payload: []byte{7, 0, 0, 0}})).To(Succeed())
+		Eventually(mux.exitStatus).Should(Receive(Equal(int32(7))))
+	})
+
+	It("rejects a malformed exit status frame", func() {
+		err := mux.handleFrame(Frame{StreamID: StreamIDExitStatus, Payload: []byte{1, 2}})
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("frameSinkServer", func() {
+	It("acks a blocked stdout write so stderr delivery isn't serialized behind it, over a real capnp.Server", func() {
+		mux, stdoutR, stderrR := newFrameMux()
+		sink := proto.FrameSink_ServerToClient(&frameSinkServer{mux: mux}, nil)
+		defer sink.Release()
+
+		writeFrame := func(id StreamID, payload string) (proto.FrameSink_write_Results_Future, capnp.ReleaseFunc) {
+			return sink.Write(context.Background(), func(p proto.FrameSink_write_Params) error {
+				frame, err := p.NewFrame()
+				if err != nil {
+					return err
+				}
+				frame.SetStreamId(proto.StreamID(id))
+				return frame.SetPayload([]byte(payload))
+			})
+		}
+
+		stdoutFuture, stdoutFree := writeFrame(StreamIDStdout, "blocked")
+		defer stdoutFree()
+
+		stdoutDone := make(chan error, 1)
+		go func() {
+			_, err := stdoutFuture.Struct()
+			stdoutDone <- err
+		}()
+
+		// No one is reading stdoutR yet, so the write call above should
+		// not have resolved, but a real capnp.Server dispatching the next
+		// call on this same capability must not be stalled behind it.
+		Consistently(stdoutDone, 50*time.Millisecond).ShouldNot(Receive())
+
+		stderrDone := make(chan error, 1)
+		go func() {
+			_, err := io.ReadAll(io.LimitReader(stderrR, 2))
+			stderrDone <- err
+		}()
+		stderrFuture, stderrFree := writeFrame(StreamIDStderr, "ok")
+		defer stderrFree()
+		_, err := stderrFuture.Struct()
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(stderrDone).Should(Receive(BeNil()))
+
+		go io.ReadAll(stdoutR)
+		Eventually(stdoutDone).Should(Receive(BeNil()))
+	})
+})
+
+type fakeResizeSender struct {
+	got chan TerminalSize
+}
+
+func (f fakeResizeSender) Resize(ctx context.Context, size TerminalSize) error {
+	f.got <- size
+	return nil
+}
+
+var _ = Describe("forwardResizes", func() {
+	It("forwards every resize event sent mid-stream, not just the first", func() {
+		sender := fakeResizeSender{got: make(chan TerminalSize, 2)}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		resize := make(chan TerminalSize)
+		done := make(chan struct{})
+		go func() {
+			forwardResizes(ctx, sender, resize)
+			close(done)
+		}()
+
+		resize <- TerminalSize{Width: 80, Height: 24}
+		resize <- TerminalSize{Width: 100, Height: 40}
+
+		Eventually(sender.got).Should(Receive(Equal(TerminalSize{Width: 80, Height: 24})))
+		Eventually(sender.got).Should(Receive(Equal(TerminalSize{Width: 100, Height: 40})))
+
+		cancel()
+		Eventually(done).Should(BeClosed())
+	})
+})